@@ -0,0 +1,174 @@
+// Package auth provides JWT session authentication and role-based
+// access control for the employee-simplified API.
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gorilla/mux"
+)
+
+// Role is the permission level carried in a session token.
+type Role string
+
+const (
+	RoleAdmin  Role = "admin"
+	RoleViewer Role = "viewer"
+)
+
+// Claims is the set of custom JWT claims issued by Login.
+type Claims struct {
+	Username string `json:"username"`
+	Role     Role   `json:"role"`
+	jwt.RegisteredClaims
+}
+
+type contextKey string
+
+const principalKey contextKey = "auth.principal"
+
+// Principal describes the authenticated caller, stashed in the request
+// context by RequireRole so handlers can read it for audit logging.
+type Principal struct {
+	Username string
+	Role     Role
+}
+
+type credential struct {
+	password string
+	role     Role
+}
+
+// Authenticator issues and validates HS256 session tokens and exposes
+// the mux middleware that gates mutating routes on role.
+type Authenticator struct {
+	secret []byte
+	ttl    time.Duration
+
+	mu      sync.Mutex
+	users   map[string]credential
+	revoked map[string]struct{} // jti -> revoked
+}
+
+// NewAuthenticator builds an Authenticator signing HS256 tokens with
+// secret, valid for ttl from issuance.
+func NewAuthenticator(secret string, ttl time.Duration) *Authenticator {
+	return &Authenticator{
+		secret:  []byte(secret),
+		ttl:     ttl,
+		users:   map[string]credential{},
+		revoked: map[string]struct{}{},
+	}
+}
+
+// AddUser registers a user allowed to log in. Intended for bootstrapping
+// a small, fixed set of operators; there is no self-service signup.
+func (a *Authenticator) AddUser(username, password string, role Role) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.users[username] = credential{password: password, role: role}
+}
+
+// Login checks username/password against the user store and issues a
+// signed token on success.
+func (a *Authenticator) Login(username, password string) (string, error) {
+	a.mu.Lock()
+	cred, ok := a.users[username]
+	a.mu.Unlock()
+	if !ok || subtle.ConstantTimeCompare([]byte(cred.password), []byte(password)) != 1 {
+		return "", errors.New("invalid username or password")
+	}
+
+	now := time.Now()
+	claims := Claims{
+		Username: username,
+		Role:     cred.role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        fmt.Sprintf("%s-%d", username, now.UnixNano()),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(a.ttl)),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(a.secret)
+}
+
+// Logout revokes a token by jti so it can no longer authenticate.
+func (a *Authenticator) Logout(tokenString string) error {
+	claims, err := a.parse(tokenString)
+	if err != nil {
+		return err
+	}
+	a.mu.Lock()
+	a.revoked[claims.ID] = struct{}{}
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *Authenticator) parse(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+		return a.secret, nil
+	})
+	if err != nil || !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+
+	a.mu.Lock()
+	_, revoked := a.revoked[claims.ID]
+	a.mu.Unlock()
+	if revoked {
+		return nil, errors.New("token has been revoked")
+	}
+	return claims, nil
+}
+
+// RequireRole returns mux middleware that rejects requests lacking a
+// valid, non-revoked Bearer token with at least the given role.
+// RoleViewer satisfies a RoleViewer requirement; only RoleAdmin
+// satisfies a RoleAdmin requirement.
+func (a *Authenticator) RequireRole(min Role) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tokenString := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if tokenString == "" {
+				writeUnauthorized(w, "missing bearer token")
+				return
+			}
+			claims, err := a.parse(tokenString)
+			if err != nil {
+				writeUnauthorized(w, "invalid or expired token")
+				return
+			}
+			if min == RoleAdmin && claims.Role != RoleAdmin {
+				http.Error(w, `{"error":{"code":"E_FORBIDDEN","message":"admin role required"}}`, http.StatusForbidden)
+				return
+			}
+			ctx := context.WithValue(r.Context(), principalKey, Principal{Username: claims.Username, Role: claims.Role})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func writeUnauthorized(w http.ResponseWriter, message string) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	http.Error(w, fmt.Sprintf(`{"error":{"code":"E_UNAUTHORIZED","message":%q}}`, message), http.StatusUnauthorized)
+}
+
+// FromContext returns the authenticated principal stashed by
+// RequireRole, if any.
+func FromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey).(Principal)
+	return p, ok
+}
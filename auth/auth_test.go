@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestAuthenticator() *Authenticator {
+	a := NewAuthenticator("test-secret", time.Hour)
+	a.AddUser("admin", "adminpass", RoleAdmin)
+	a.AddUser("viewer", "viewerpass", RoleViewer)
+	return a
+}
+
+func TestLoginSuccessAndFailure(t *testing.T) {
+	a := newTestAuthenticator()
+
+	token, err := a.Login("admin", "adminpass")
+	if err != nil || token == "" {
+		t.Fatalf("expected a token, got token=%q err=%v", token, err)
+	}
+
+	if _, err := a.Login("admin", "wrongpass"); err == nil {
+		t.Error("expected login with a wrong password to fail")
+	}
+	if _, err := a.Login("nobody", "whatever"); err == nil {
+		t.Error("expected login for an unknown user to fail")
+	}
+}
+
+func protectedHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if p, ok := FromContext(r.Context()); ok {
+			w.Header().Set("X-Principal", p.Username+":"+string(p.Role))
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func TestRequireRoleRejectsMissingOrInvalidToken(t *testing.T) {
+	a := newTestAuthenticator()
+	handler := a.RequireRole(RoleViewer)(protectedHandler())
+
+	req := httptest.NewRequest(http.MethodGet, "/employees", nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401 with no token, got %d", rr.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/employees", nil)
+	req.Header.Set("Authorization", "Bearer not-a-real-token")
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401 with a garbage token, got %d", rr.Code)
+	}
+}
+
+func TestRequireRoleEnforcesMinimumRole(t *testing.T) {
+	a := newTestAuthenticator()
+	viewerToken, _ := a.Login("viewer", "viewerpass")
+
+	handler := a.RequireRole(RoleAdmin)(protectedHandler())
+	req := httptest.NewRequest(http.MethodPost, "/employee", nil)
+	req.Header.Set("Authorization", "Bearer "+viewerToken)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusForbidden {
+		t.Errorf("expected a viewer token to be forbidden on an admin route, got %d", rr.Code)
+	}
+}
+
+func TestRequireRoleAllowsSufficientRoleAndSetsPrincipal(t *testing.T) {
+	a := newTestAuthenticator()
+	adminToken, _ := a.Login("admin", "adminpass")
+
+	handler := a.RequireRole(RoleViewer)(protectedHandler())
+	req := httptest.NewRequest(http.MethodGet, "/employees", nil)
+	req.Header.Set("Authorization", "Bearer "+adminToken)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rr.Code)
+	}
+	if got := rr.Header().Get("X-Principal"); got != "admin:admin" {
+		t.Errorf("expected principal admin:admin in context, got %q", got)
+	}
+}
+
+func TestLogoutRevokesToken(t *testing.T) {
+	a := newTestAuthenticator()
+	token, _ := a.Login("admin", "adminpass")
+
+	handler := a.RequireRole(RoleAdmin)(protectedHandler())
+	req := httptest.NewRequest(http.MethodPost, "/employee", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected token to work before logout, got %d", rr.Code)
+	}
+
+	if err := a.Logout(token); err != nil {
+		t.Fatalf("Logout: %v", err)
+	}
+
+	rr = httptest.NewRecorder()
+	req.Header.Set("Authorization", "Bearer "+token)
+	handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusUnauthorized {
+		t.Errorf("expected a revoked token to be rejected, got %d", rr.Code)
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/saurabhagg301/employee-simplified/auth"
+)
+
+type loginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// Login exchanges a username/password for a signed session token.
+func Login(w http.ResponseWriter, r *http.Request) {
+	var req loginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, errCodeDecode, "Failed to decode request payload", nil)
+		return
+	}
+
+	token, err := authn.Login(req.Username, req.Password)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "E_UNAUTHORIZED", err.Error(), nil)
+		return
+	}
+	webJSONResponse(w, http.StatusOK, map[string]interface{}{"token": token})
+}
+
+// Logout revokes the caller's Bearer token so it can no longer
+// authenticate.
+func Logout(w http.ResponseWriter, r *http.Request) {
+	tokenString := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if tokenString == "" {
+		writeError(w, http.StatusBadRequest, errCodeDecode, "missing bearer token", nil)
+		return
+	}
+	if err := authn.Logout(tokenString); err != nil {
+		writeError(w, http.StatusUnauthorized, "E_UNAUTHORIZED", err.Error(), nil)
+		return
+	}
+	webJSONResponse(w, http.StatusOK, map[string]interface{}{"logged_out": true})
+}
+
+// auditLog records which authenticated principal performed a mutation,
+// for later audit review.
+func auditLog(r *http.Request, action string) {
+	p, ok := auth.FromContext(r.Context())
+	if !ok {
+		return
+	}
+	log.Printf("%s by %s (%s)", action, p.Username, p.Role)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// errPatchTestFailed is returned by applyJSONPatchOp when a "test"
+// operation does not match, per RFC 6902 section 4.6.
+var errPatchTestFailed = errors.New("patch test operation failed")
+
+// jsonPatchOp is a single RFC 6902 operation.
+type jsonPatchOp struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	From  string      `json:"from,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// employeeToMap round-trips an employee through JSON so it can be
+// patched as a plain document.
+func employeeToMap(e employee) (map[string]interface{}, error) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// mapToEmployee round-trips a patched document back into an employee.
+func mapToEmployee(doc map[string]interface{}) (employee, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return employee{}, err
+	}
+	var e employee
+	if err := json.Unmarshal(data, &e); err != nil {
+		return employee{}, err
+	}
+	return e, nil
+}
+
+// applyJSONPatchOp applies a single RFC 6902 operation to doc. employee
+// documents are flat, so only top-level JSON pointers ("/name", "/age",
+// ...) are supported.
+func applyJSONPatchOp(doc map[string]interface{}, op jsonPatchOp) error {
+	key := strings.TrimPrefix(op.Path, "/")
+	switch op.Op {
+	case "add", "replace":
+		doc[key] = op.Value
+	case "remove":
+		delete(doc, key)
+	case "test":
+		if !reflect.DeepEqual(doc[key], op.Value) {
+			return fmt.Errorf("%w: path %q", errPatchTestFailed, op.Path)
+		}
+	case "move":
+		fromKey := strings.TrimPrefix(op.From, "/")
+		doc[key] = doc[fromKey]
+		delete(doc, fromKey)
+	case "copy":
+		fromKey := strings.TrimPrefix(op.From, "/")
+		doc[key] = doc[fromKey]
+	default:
+		return fmt.Errorf("unsupported patch operation %q", op.Op)
+	}
+	return nil
+}
+
+// applyMergePatch applies an RFC 7396 JSON merge patch: a null value
+// deletes the corresponding field, anything else overwrites it.
+func applyMergePatch(doc map[string]interface{}, patch map[string]interface{}) {
+	for k, v := range patch {
+		if v == nil {
+			delete(doc, k)
+			continue
+		}
+		doc[k] = v
+	}
+}
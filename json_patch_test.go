@@ -0,0 +1,100 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestApplyJSONPatchOpAddReplaceRemove(t *testing.T) {
+	doc := map[string]interface{}{"name": "Bob", "age": float64(30)}
+
+	if err := applyJSONPatchOp(doc, jsonPatchOp{Op: "replace", Path: "/age", Value: float64(31)}); err != nil {
+		t.Fatalf("replace: %v", err)
+	}
+	if doc["age"] != float64(31) {
+		t.Errorf("expected age 31, got %v", doc["age"])
+	}
+
+	if err := applyJSONPatchOp(doc, jsonPatchOp{Op: "remove", Path: "/age"}); err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+	if _, ok := doc["age"]; ok {
+		t.Error("expected age to be removed")
+	}
+
+	if err := applyJSONPatchOp(doc, jsonPatchOp{Op: "add", Path: "/age", Value: float64(40)}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if doc["age"] != float64(40) {
+		t.Errorf("expected age 40, got %v", doc["age"])
+	}
+}
+
+func TestApplyJSONPatchOpTest(t *testing.T) {
+	doc := map[string]interface{}{"name": "Bob"}
+
+	if err := applyJSONPatchOp(doc, jsonPatchOp{Op: "test", Path: "/name", Value: "Bob"}); err != nil {
+		t.Errorf("expected matching test op to succeed, got %v", err)
+	}
+
+	err := applyJSONPatchOp(doc, jsonPatchOp{Op: "test", Path: "/name", Value: "Sara"})
+	if !errors.Is(err, errPatchTestFailed) {
+		t.Errorf("expected errPatchTestFailed, got %v", err)
+	}
+}
+
+func TestApplyJSONPatchOpMoveAndCopy(t *testing.T) {
+	doc := map[string]interface{}{"name": "Bob"}
+
+	if err := applyJSONPatchOp(doc, jsonPatchOp{Op: "copy", From: "/name", Path: "/nickname"}); err != nil {
+		t.Fatalf("copy: %v", err)
+	}
+	if doc["nickname"] != "Bob" || doc["name"] != "Bob" {
+		t.Errorf("expected copy to duplicate the value, got %+v", doc)
+	}
+
+	if err := applyJSONPatchOp(doc, jsonPatchOp{Op: "move", From: "/nickname", Path: "/alias"}); err != nil {
+		t.Fatalf("move: %v", err)
+	}
+	if _, ok := doc["nickname"]; ok {
+		t.Error("expected move to remove the source key")
+	}
+	if doc["alias"] != "Bob" {
+		t.Errorf("expected alias to be Bob, got %v", doc["alias"])
+	}
+}
+
+func TestApplyJSONPatchOpUnknownOp(t *testing.T) {
+	doc := map[string]interface{}{"name": "Bob"}
+	if err := applyJSONPatchOp(doc, jsonPatchOp{Op: "bogus", Path: "/name"}); err == nil {
+		t.Error("expected an error for an unsupported op")
+	}
+}
+
+func TestApplyMergePatchDeletesNullsAndOverwrites(t *testing.T) {
+	doc := map[string]interface{}{"name": "Bob", "age": float64(30)}
+	applyMergePatch(doc, map[string]interface{}{"age": nil, "name": "Bobby"})
+
+	if _, ok := doc["age"]; ok {
+		t.Error("expected age to be deleted by a null merge patch value")
+	}
+	if doc["name"] != "Bobby" {
+		t.Errorf("expected name to be overwritten, got %v", doc["name"])
+	}
+}
+
+func TestEmployeeMapRoundTrip(t *testing.T) {
+	e := employee{Id: 1, Name: "Bob", Age: 30}
+	doc, err := employeeToMap(e)
+	if err != nil {
+		t.Fatalf("employeeToMap: %v", err)
+	}
+
+	got, err := mapToEmployee(doc)
+	if err != nil {
+		t.Fatalf("mapToEmployee: %v", err)
+	}
+	if got != e {
+		t.Errorf("expected round trip to preserve the employee, got %+v", got)
+	}
+}
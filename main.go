@@ -1,9 +1,14 @@
 /*
+LOGIN / LOGOUT
+--------------
+TOKEN=$(curl -sX POST http://localhost:8085/login -d '{"username":"admin","password":"admin"}' | jq -r .token)
+curl -sX POST http://localhost:8085/logout -H "Authorization: Bearer $TOKEN"
+
 POST
 ------
-curl -sX POST http://localhost:8085/employee -d '{"name":"Bob", "age":30}' | jq
-curl -sX POST http://localhost:8085/employee -d '{"name":"Sara", "age":34}' | jq
-curl -sX POST http://localhost:8085/employee -d '{"name":"Mike", "age":36}' | jq
+curl -sX POST http://localhost:8085/employee -H "Authorization: Bearer $TOKEN" -d '{"name":"Bob", "age":30}' | jq
+curl -sX POST http://localhost:8085/employee -H "Authorization: Bearer $TOKEN" -d '{"name":"Sara", "age":34}' | jq
+curl -sX POST http://localhost:8085/employee -H "Authorization: Bearer $TOKEN" -d '{"name":"Mike", "age":36}' | jq
 
 PUT
 ----
@@ -13,11 +18,15 @@ curl -sX PUT http://localhost:8085/employee/1 -d '{"age":34}' | jq
 PATCH
 --------
 curl -sX PATCH http://localhost:8085/employee/2 -d '{"age":70}' | jq
+curl -sX PATCH http://localhost:8085/employee/2 -H "Authorization: Bearer $TOKEN" -H 'Content-Type: application/merge-patch+json' -d '{"age":null}' | jq
+curl -sX PATCH http://localhost:8085/employee/2 -H "Authorization: Bearer $TOKEN" -H 'Content-Type: application/json-patch+json' -d '[{"op":"replace","path":"/age","value":70}]' | jq
 
 
 GET
 ---
 curl -sX GET http://localhost:8085/employees | jq
+curl -sX GET 'http://localhost:8085/employees?sort=age&order=desc&limit=10' -H "Authorization: Bearer $TOKEN" | jq
+curl -sX GET 'http://localhost:8085/employees?name=Bob&age_gte=30&age_lte=40' -H "Authorization: Bearer $TOKEN" | jq
 curl -sX GET http://localhost:8085/employee/1 | jq
 curl -sX GET http://localhost:8085/employee/Bob | jq
 
@@ -25,53 +34,147 @@ DELETE
 ----------
 curl -sX DELETE http://localhost:8085/employee/Bob | jq
 curl -sX DELETE http://localhost:8085/employee/3 | jq
+
+WATCH
+--------
+curl -sX GET 'http://localhost:8085/employees/watch?index=0&timeout=30s' -H "Authorization: Bearer $TOKEN" | jq
 */
 
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
 	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/saurabhagg301/employee-simplified/auth"
 )
 
 type employee struct {
 	Id   int    `json:"id"`
-	Name string `json:"name"`
-	Age  int    `json:"age"`
+	Name string `json:"name" validate:"required,min=1,max=100"`
+	Age  int    `json:"age" validate:"gte=0,lte=150"`
 }
 
 // global variables
 var (
-	employeeDB = []employee{}
-	empIDSeq   = 0
+	store      Store
+	empWatcher = newWatcher()
+	authn      *auth.Authenticator
 	host       = "127.0.0.1"
 	port       = 8085
 )
 
+func getenv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getenvDuration(key string, fallback time.Duration) time.Duration {
+	if v := os.Getenv(key); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return fallback
+}
+
+// timeoutMiddleware bounds a request to d and propagates the deadline
+// through r.Context() so handlers and their store calls can abort
+// cleanly instead of racing the client past a dead connection. It is
+// applied to every route except /employees/watch, which enforces its
+// own caller-supplied ?timeout= via empWatcher.wait instead.
+func timeoutMiddleware(d time.Duration) mux.MiddlewareFunc {
+	const timeoutMsg = `{"error":{"code":"E_TIMEOUT","message":"request timed out"}}`
+	return func(next http.Handler) http.Handler {
+		return http.TimeoutHandler(next, d, timeoutMsg)
+	}
+}
+
 func main() {
+	driver := getenv("EMPLOYEE_STORE_DRIVER", "memory")
+	dsn := getenv("EMPLOYEE_STORE_DSN", "")
+	requestTimeout := getenvDuration("EMPLOYEE_REQUEST_TIMEOUT", 15*time.Second)
+	shutdownTimeout := getenvDuration("EMPLOYEE_SHUTDOWN_TIMEOUT", 10*time.Second)
+
+	s, err := NewStore(driver, dsn)
+	if err != nil {
+		log.Fatalf("failed to initialize store: %v", err)
+	}
+	store = s
+
+	authn = auth.NewAuthenticator(getenv("EMPLOYEE_AUTH_SECRET", "dev-secret-change-me"), 12*time.Hour)
+	authn.AddUser(getenv("EMPLOYEE_ADMIN_USER", "admin"), getenv("EMPLOYEE_ADMIN_PASSWORD", "admin"), auth.RoleAdmin)
+	authn.AddUser(getenv("EMPLOYEE_VIEWER_USER", "viewer"), getenv("EMPLOYEE_VIEWER_PASSWORD", "viewer"), auth.RoleViewer)
+
 	r := mux.NewRouter()
 	srvr := http.Server{
-		Addr:         fmt.Sprintf("%s:%d", host, port),
-		Handler:      r, // *** To attach mux router to server
-		ReadTimeout:  15 * time.Second,
-		WriteTimeout: 15 * time.Second,
+		Addr:    fmt.Sprintf("%s:%d", host, port),
+		Handler: r, // *** To attach mux router to server
+		// No ReadTimeout/WriteTimeout here: those bound the whole
+		// connection regardless of route, which would kill
+		// /employees/watch's long poll out from under it. Only bound
+		// the time to read request headers; per-route deadlines are
+		// enforced by timeoutMiddleware (and by WatchEmployees itself).
+		ReadHeaderTimeout: 5 * time.Second,
 	}
-	r.HandleFunc("/employee", AddEmployee).Methods("POST")
-	r.HandleFunc("/employees", GetEmployees).Methods("GET")
-	r.HandleFunc("/employee/{nameORId}", GetEmployee).Methods("GET") // *** Note that query params should be enclosed within curly braces
-	r.HandleFunc("/employee/{id}", UpdateEmployee).Methods("PUT")
-	r.HandleFunc("/employee/{id}", PartialUpdateEmployee).Methods("PATCH")
-	r.HandleFunc("/employee/{nameORId}", DeleteEmployee).Methods("DELETE")
 
-	log.Fatal(srvr.ListenAndServe())
+	// bounded carries timeoutMiddleware for every route except the
+	// watch long-poll, which is registered directly on r below.
+	bounded := r.NewRoute().Subrouter()
+	bounded.Use(timeoutMiddleware(requestTimeout))
+	bounded.HandleFunc("/login", Login).Methods("POST")
+	bounded.HandleFunc("/logout", Logout).Methods("POST")
+
+	// viewer gates every GET, admin gates every mutating route; both
+	// subrouters match everything so Use() applies the middleware to
+	// whatever HandleFunc call is attached to them below.
+	viewer := bounded.NewRoute().Subrouter()
+	viewer.Use(authn.RequireRole(auth.RoleViewer))
+	viewer.HandleFunc("/employees", GetEmployees).Methods("GET")
+	viewer.HandleFunc("/employee/{nameORId}", GetEmployee).Methods("GET") // *** Note that query params should be enclosed within curly braces
+
+	admin := bounded.NewRoute().Subrouter()
+	admin.Use(authn.RequireRole(auth.RoleAdmin))
+	admin.HandleFunc("/employee", AddEmployee).Methods("POST")
+	admin.HandleFunc("/employee/{id}", UpdateEmployee).Methods("PUT")
+	admin.HandleFunc("/employee/{id}", PartialUpdateEmployee).Methods("PATCH")
+	admin.HandleFunc("/employee/{nameORId}", DeleteEmployee).Methods("DELETE")
+
+	// /employees/watch bounds itself via ?timeout= (see WatchEmployees),
+	// so it is exempt from timeoutMiddleware and from bounded's subrouter
+	// chain entirely.
+	watch := r.NewRoute().Subrouter()
+	watch.Use(authn.RequireRole(auth.RoleViewer))
+	watch.HandleFunc("/employees/watch", WatchEmployees).Methods("GET")
+
+	go func() {
+		if err := srvr.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("server error: %v", err)
+		}
+	}()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	<-stop
 
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := srvr.Shutdown(ctx); err != nil {
+		log.Printf("graceful shutdown failed: %v", err)
+	}
 }
 
 func webJSONResponse(w http.ResponseWriter, statusCode int, payload interface{}) {
@@ -86,9 +189,118 @@ func webJSONResponse(w http.ResponseWriter, statusCode int, payload interface{})
 	w.Write(response)
 }
 
-// GetEmployees to get all employees
+// storeErrStatus maps a Store error to the HTTP status it should be
+// reported as.
+func storeErrStatus(err error) int {
+	if errors.Is(err, ErrNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+// storeErrCode maps a Store error to the error envelope code it should
+// be reported as.
+func storeErrCode(err error) errorCode {
+	if errors.Is(err, ErrNotFound) {
+		return errCodeNotFound
+	}
+	return "E_INTERNAL"
+}
+
+// storeErrMessage renders a Store error for the client: the friendly
+// notFoundMsg when the record simply doesn't exist, or the underlying
+// error's own text for anything else (e.g. a real DB I/O failure),
+// so callers don't get told "no record" when the backend is actually
+// unreachable.
+func storeErrMessage(err error, notFoundMsg string) string {
+	if errors.Is(err, ErrNotFound) {
+		return notFoundMsg
+	}
+	return err.Error()
+}
+
+// GetEmployees lists employees, applying the ?name=, ?age_gte=,
+// ?age_lte= filters, the ?sort=/?order= ordering, and then ?limit=
+// /?offset= (or opaque ?cursor=) pagination, in that order. The
+// response wraps the page with its total count and a next_cursor for
+// walking forward; Link headers with rel="next"/"prev" are set for
+// standard pagination clients.
 func GetEmployees(w http.ResponseWriter, r *http.Request) {
-	webJSONResponse(w, http.StatusOK, map[string]interface{}{"employees": employeeDB})
+	all, err := store.List(r.Context())
+	if err != nil {
+		webJSONResponse(w, storeErrStatus(err), map[string]interface{}{"error": err.Error()})
+		return
+	}
+
+	q := r.URL.Query()
+	filtered := filterEmployees(all, q)
+	sortEmployees(filtered, q.Get("sort"), q.Get("order"))
+
+	total := len(filtered)
+	limit, offset := paginationParams(q, total)
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	page := filtered[offset:end]
+
+	var nextCursor string
+	if end < total {
+		nextCursor = encodeCursor(end)
+		setLinkHeader(w, r, "next", nextCursor)
+	}
+	if offset > 0 {
+		prevOffset := offset - limit
+		if prevOffset < 0 {
+			prevOffset = 0
+		}
+		setLinkHeader(w, r, "prev", encodeCursor(prevOffset))
+	}
+
+	webJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"employees":   page,
+		"total":       total,
+		"next_cursor": nextCursor,
+	})
+}
+
+// setLinkHeader adds a standard Link header pointing back at this
+// request with ?cursor= swapped for the given rel.
+func setLinkHeader(w http.ResponseWriter, r *http.Request, rel, cursor string) {
+	u := *r.URL
+	qq := u.Query()
+	qq.Set("cursor", cursor)
+	u.RawQuery = qq.Encode()
+	w.Header().Add("Link", fmt.Sprintf(`<%s>; rel=%q`, u.String(), rel))
+}
+
+// WatchEmployees implements an etcd-style long poll: it blocks until an
+// employee is created/updated/deleted with an index greater than
+// ?index=, then returns that change event. Pass ?timeout= (a
+// time.ParseDuration string, default 30s) to bound how long the
+// request blocks, and ?wait=false to only check for a pending event
+// without blocking. Returns 504 if no qualifying change shows up in
+// time.
+func WatchEmployees(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	since, _ := strconv.ParseUint(q.Get("index"), 10, 64)
+
+	timeout := 30 * time.Second
+	if t := q.Get("timeout"); t != "" {
+		if d, err := time.ParseDuration(t); err == nil {
+			timeout = d
+		}
+	}
+	if q.Get("wait") == "false" {
+		timeout = 0
+	}
+
+	ev, ok := empWatcher.wait(r.Context(), since, timeout)
+	if !ok {
+		webJSONResponse(w, http.StatusGatewayTimeout, map[string]interface{}{"error": "watch timed out waiting for a change"})
+		return
+	}
+	webJSONResponse(w, http.StatusOK, ev)
 }
 
 // AddEmployee to add an employee
@@ -96,13 +308,21 @@ func AddEmployee(w http.ResponseWriter, r *http.Request) {
 	e := employee{}
 	errDecode := json.NewDecoder(r.Body).Decode(&e) // ***For decoding request payload
 	if errDecode != nil {
-		webJSONResponse(w, 400, map[string]interface{}{"error": "Failed to decode request payload"})
+		writeError(w, http.StatusBadRequest, errCodeDecode, "Failed to decode request payload", nil)
+		return
+	}
+	if fields := validateEmployee(e); fields != nil {
+		writeError(w, http.StatusUnprocessableEntity, errCodeValidation, "validation failed", fields)
 		return
 	}
-	empIDSeq++
-	e.Id = empIDSeq
-	employeeDB = append(employeeDB, e)
-	msg := fmt.Sprintf("Employee with id %d created successfully", empIDSeq)
+	created, err := store.Create(r.Context(), e)
+	if err != nil {
+		writeError(w, storeErrStatus(err), storeErrCode(err), err.Error(), nil)
+		return
+	}
+	empWatcher.notify(actionCreated, created)
+	auditLog(r, "employee create")
+	msg := fmt.Sprintf("Employee with id %d created successfully", created.Id)
 	webJSONResponse(w, 201, map[string]interface{}{"created": msg})
 }
 
@@ -111,64 +331,23 @@ func GetEmployee(w http.ResponseWriter, r *http.Request) {
 	nameORId := vars["nameORId"]
 	id, errDecodeID := strconv.ParseInt(nameORId, 10, 64)
 	var e employee
-	var statusCode int
 	var err error
 	if id > 0 && errDecodeID == nil {
 		// input query param is a id
-		// call getEmployeeByID
-		e, statusCode, err = getEmployeeByID(id)
-		if err != nil {
-			webJSONResponse(w, statusCode, map[string]interface{}{"error": err.Error()})
-			return
-		}
+		e, err = store.Get(r.Context(), int(id))
 	} else {
 		// input query param is a name
-		// call deleteEmployeeByName
-		e, statusCode, err = getEmployeeByName(nameORId)
-		if err != nil {
-			webJSONResponse(w, statusCode, map[string]interface{}{"error": err.Error()})
-			return
-		}
+		e, err = store.GetByName(r.Context(), nameORId)
+	}
+	if err != nil {
+		webJSONResponse(w, storeErrStatus(err), map[string]interface{}{"error": fmt.Sprintf("No record exists for %q", nameORId)})
+		return
 	}
 
 	// return
 	webJSONResponse(w, http.StatusOK, map[string]interface{}{"employee": e})
 }
 
-func getEmployeeByID(id int64) (employee, int, error) {
-	flagFound := false
-	var res employee
-	for _, v := range employeeDB {
-		if v.Id == int(id) {
-			flagFound = true
-			res = v
-			break
-		}
-	}
-	if !flagFound {
-		return res, http.StatusNotFound, errors.New(fmt.Sprintf("No record exists for id %d", id))
-	}
-
-	return res, http.StatusOK, nil
-}
-
-func getEmployeeByName(name string) (employee, int, error) {
-	flagFound := false
-	var res employee
-	for _, v := range employeeDB {
-		if v.Name == name {
-			flagFound = true
-			res = v
-			break
-		}
-	}
-	if !flagFound {
-		return res, http.StatusNotFound, errors.New(fmt.Sprintf("No record exists for name %s", name))
-	}
-
-	return res, http.StatusOK, nil
-}
-
 func UpdateEmployee(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	id, _ := strconv.Atoi(vars["id"])
@@ -176,55 +355,107 @@ func UpdateEmployee(w http.ResponseWriter, r *http.Request) {
 	errDecode := json.NewDecoder(r.Body).Decode(&e)
 	if errDecode != nil {
 		// return error
-		webJSONResponse(w, http.StatusBadRequest, map[string]interface{}{"error": "Falied to decode request payload"})
+		writeError(w, http.StatusBadRequest, errCodeDecode, "Failed to decode request payload", nil)
 		return
 	}
-	e.Id = id // assign id value same as before as id cannot be updated by user
-
-	var flagFound bool
-	for k, v := range employeeDB {
-		if v.Id == id {
-			flagFound = true
-			employeeDB[k] = e
-			break
-		}
+	if fields := validateEmployee(e); fields != nil {
+		writeError(w, http.StatusUnprocessableEntity, errCodeValidation, "validation failed", fields)
+		return
 	}
-	if !flagFound {
-		webJSONResponse(w, http.StatusNotFound, map[string]interface{}{"error": fmt.Sprintf("No record exists for id %d", id)})
+
+	updated, err := store.Update(r.Context(), id, e)
+	if err != nil {
+		writeError(w, storeErrStatus(err), storeErrCode(err), storeErrMessage(err, fmt.Sprintf("No record exists for id %d", id)), nil)
+		return
 	}
+	empWatcher.notify(actionUpdated, updated)
+	auditLog(r, "employee update")
 
 	// return
 	webJSONResponse(w, http.StatusOK, map[string]interface{}{"updated": fmt.Sprintf("Employee id %d updated successfully", id)})
 }
 
+// PartialUpdateEmployee applies a PATCH to an employee. The body is
+// interpreted according to Content-Type: "application/json-patch+json"
+// (RFC 6902 op/path/value operations), "application/merge-patch+json"
+// (RFC 7396, where nulls delete fields), or plain "application/json"
+// (today's behavior: decode onto the existing record, so omitted
+// fields are left untouched but fields cannot be cleared).
 func PartialUpdateEmployee(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	id, _ := strconv.Atoi(vars["id"])
-	var e employee
-	var flagFound bool
-	for k, v := range employeeDB {
-		if v.Id == id {
-			flagFound = true
-			// fetch current values for the specific employee into e
-			e = employeeDB[k]
-
-			// update/overwrite values passed in the request payload
-			errDecode := json.NewDecoder(r.Body).Decode(&e)
-			if errDecode != nil {
-				// return error
-				webJSONResponse(w, http.StatusBadRequest, map[string]interface{}{"error": "Falied to decode request payload"})
+
+	current, err := store.Get(r.Context(), id)
+	if err != nil {
+		writeError(w, storeErrStatus(err), storeErrCode(err), storeErrMessage(err, fmt.Sprintf("No record exists for id %d", id)), nil)
+		return
+	}
+
+	doc, err := employeeToMap(current)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "E_INTERNAL", err.Error(), nil)
+		return
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	switch {
+	case strings.HasPrefix(contentType, "application/json-patch+json"):
+		var ops []jsonPatchOp
+		if err := json.NewDecoder(r.Body).Decode(&ops); err != nil {
+			writeError(w, http.StatusBadRequest, errCodeDecode, "Failed to decode request payload", nil)
+			return
+		}
+		for _, op := range ops {
+			if err := applyJSONPatchOp(doc, op); err != nil {
+				if errors.Is(err, errPatchTestFailed) {
+					writeError(w, http.StatusConflict, errCodeConflict, err.Error(), nil)
+					return
+				}
+				writeError(w, http.StatusBadRequest, errCodeDecode, err.Error(), nil)
 				return
 			}
-			e.Id = id // assign id value same as before as id cannot be updated by user
-
-			// replace update employee value in the employeeDB
-			employeeDB[k] = e
-			break
 		}
+	case strings.HasPrefix(contentType, "application/merge-patch+json"):
+		var patch map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+			writeError(w, http.StatusBadRequest, errCodeDecode, "Failed to decode request payload", nil)
+			return
+		}
+		applyMergePatch(doc, patch)
+	case contentType == "", strings.HasPrefix(contentType, "application/json"):
+		patched := current
+		if err := json.NewDecoder(r.Body).Decode(&patched); err != nil {
+			writeError(w, http.StatusBadRequest, errCodeDecode, "Failed to decode request payload", nil)
+			return
+		}
+		if doc, err = employeeToMap(patched); err != nil {
+			writeError(w, http.StatusInternalServerError, "E_INTERNAL", err.Error(), nil)
+			return
+		}
+	default:
+		writeError(w, http.StatusUnsupportedMediaType, errCodeDecode, fmt.Sprintf("unsupported patch content type %q", contentType), nil)
+		return
+	}
+
+	e, err := mapToEmployee(doc)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errCodeDecode, err.Error(), nil)
+		return
+	}
+	e.Id = id // assign id value same as before as id cannot be updated by user
+
+	if fields := validateEmployee(e); fields != nil {
+		writeError(w, http.StatusUnprocessableEntity, errCodeValidation, "validation failed", fields)
+		return
 	}
-	if !flagFound {
-		webJSONResponse(w, http.StatusNotFound, map[string]interface{}{"error": fmt.Sprintf("No record exists for id %d", id)})
+
+	saved, err := store.PartialUpdate(r.Context(), id, e)
+	if err != nil {
+		writeError(w, storeErrStatus(err), storeErrCode(err), storeErrMessage(err, fmt.Sprintf("No record exists for id %d", id)), nil)
+		return
 	}
+	empWatcher.notify(actionUpdated, saved)
+	auditLog(r, "employee partial update")
 
 	// return
 	webJSONResponse(w, http.StatusOK, map[string]interface{}{"updated": fmt.Sprintf("Employee id %d updated successfully", id)})
@@ -236,24 +467,26 @@ func DeleteEmployee(w http.ResponseWriter, r *http.Request) {
 	nameORId := vars["nameORId"]
 	var flagInputID bool
 	id, errDecodeID := strconv.ParseInt(nameORId, 10, 64)
+	var deleted employee
+	var err error
 	if id > 0 && errDecodeID == nil {
 		// input query param is a id
 		flagInputID = true
-		// call deleteEmployeeByID
-		statusCode, err := deleteEmployeeByID(id)
-		if err != nil {
-			webJSONResponse(w, statusCode, map[string]interface{}{"error": err.Error()})
-			return
+		if deleted, err = store.Get(r.Context(), int(id)); err == nil {
+			err = store.Delete(r.Context(), int(id))
 		}
 	} else {
 		// input query param is a name
-		// call deleteEmployeeByName
-		statusCode, err := deleteEmployeeByName(nameORId)
-		if err != nil {
-			webJSONResponse(w, statusCode, map[string]interface{}{"error": err.Error()})
-			return
+		if deleted, err = store.GetByName(r.Context(), nameORId); err == nil {
+			err = store.DeleteByName(r.Context(), nameORId)
 		}
 	}
+	if err != nil {
+		webJSONResponse(w, storeErrStatus(err), map[string]interface{}{"error": fmt.Sprintf("No record exists for %q", nameORId)})
+		return
+	}
+	empWatcher.notify(actionDeleted, deleted)
+	auditLog(r, "employee delete")
 	var msg string
 	if flagInputID {
 		msg = fmt.Sprintf("Employee with id %d deleted successfully", id)
@@ -263,35 +496,3 @@ func DeleteEmployee(w http.ResponseWriter, r *http.Request) {
 	}
 	webJSONResponse(w, http.StatusOK, map[string]interface{}{"deleted": msg})
 }
-
-func deleteEmployeeByID(id int64) (int, error) {
-	var flagFound bool
-	for k, v := range employeeDB {
-		if v.Id == int(id) {
-			flagFound = true
-			employeeDB = append(employeeDB[:k], employeeDB[k+1:]...)
-			break
-		}
-	}
-	if !flagFound {
-		return http.StatusNotFound, errors.New(fmt.Sprintf("No record exists for employee id %d", id))
-	}
-	// return success
-	return http.StatusOK, nil
-}
-
-func deleteEmployeeByName(name string) (int, error) {
-	var flagFound bool
-	for k, v := range employeeDB {
-		if v.Name == name {
-			flagFound = true
-			employeeDB = append(employeeDB[:k], employeeDB[k+1:]...)
-			break
-		}
-	}
-	if !flagFound {
-		return http.StatusNotFound, errors.New(fmt.Sprintf("No record exists for employee '%s'", name))
-	}
-	// return success
-	return http.StatusOK, nil
-}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/base64"
+	"net/url"
+	"sort"
+	"strconv"
+)
+
+// defaultLimit bounds the page size returned by GetEmployees when the
+// caller doesn't pass ?limit=.
+const defaultLimit = 50
+
+// filterEmployees applies the ?name=, ?age_gte= and ?age_lte= query
+// filters supported by GetEmployees.
+func filterEmployees(in []employee, q url.Values) []employee {
+	name := q.Get("name")
+	ageGTE, hasGTE := parseIntParam(q, "age_gte")
+	ageLTE, hasLTE := parseIntParam(q, "age_lte")
+
+	out := in[:0:0]
+	for _, e := range in {
+		if name != "" && e.Name != name {
+			continue
+		}
+		if hasGTE && e.Age < ageGTE {
+			continue
+		}
+		if hasLTE && e.Age > ageLTE {
+			continue
+		}
+		out = append(out, e)
+	}
+	return out
+}
+
+func parseIntParam(q url.Values, key string) (int, bool) {
+	v := q.Get(key)
+	if v == "" {
+		return 0, false
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// sortEmployees sorts in place by the requested field (name, age, or
+// the default id) and direction (asc, or desc).
+func sortEmployees(in []employee, field, order string) {
+	less := func(i, j int) bool {
+		switch field {
+		case "name":
+			return in[i].Name < in[j].Name
+		case "age":
+			return in[i].Age < in[j].Age
+		default:
+			return in[i].Id < in[j].Id
+		}
+	}
+	if order == "desc" {
+		sort.SliceStable(in, func(i, j int) bool { return less(j, i) })
+		return
+	}
+	sort.SliceStable(in, less)
+}
+
+// paginationParams resolves the page window from ?limit=, ?offset= and
+// the opaque ?cursor= token (cursor takes precedence over offset).
+func paginationParams(q url.Values, total int) (limit, offset int) {
+	limit = defaultLimit
+	if v := q.Get("limit"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			limit = n
+		}
+	}
+
+	if c := q.Get("cursor"); c != "" {
+		if n, err := decodeCursor(c); err == nil {
+			offset = n
+		}
+	} else if v := q.Get("offset"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			offset = n
+		}
+	}
+	if offset > total {
+		offset = total
+	}
+	return limit, offset
+}
+
+// encodeCursor/decodeCursor keep the cursor opaque to clients; today it
+// is just a wrapped offset, but callers must not rely on that.
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodeCursor(c string) (int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(c)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(string(b))
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestFilterEmployees(t *testing.T) {
+	in := []employee{
+		{Id: 1, Name: "Bob", Age: 25},
+		{Id: 2, Name: "Sara", Age: 34},
+		{Id: 3, Name: "Mike", Age: 40},
+	}
+
+	q, _ := url.ParseQuery("name=Sara")
+	got := filterEmployees(in, q)
+	if len(got) != 1 || got[0].Name != "Sara" {
+		t.Errorf("expected only Sara, got %+v", got)
+	}
+
+	q, _ = url.ParseQuery("age_gte=30&age_lte=35")
+	got = filterEmployees(in, q)
+	if len(got) != 1 || got[0].Name != "Sara" {
+		t.Errorf("expected only Sara in range, got %+v", got)
+	}
+
+	q, _ = url.ParseQuery("")
+	got = filterEmployees(in, q)
+	if len(got) != len(in) {
+		t.Errorf("expected no filtering with no params, got %+v", got)
+	}
+}
+
+func TestSortEmployees(t *testing.T) {
+	in := []employee{
+		{Id: 2, Name: "Sara", Age: 34},
+		{Id: 1, Name: "Bob", Age: 25},
+		{Id: 3, Name: "Mike", Age: 40},
+	}
+
+	sortEmployees(in, "age", "asc")
+	if in[0].Name != "Bob" || in[2].Name != "Mike" {
+		t.Errorf("expected ascending age order, got %+v", in)
+	}
+
+	sortEmployees(in, "name", "desc")
+	if in[0].Name != "Sara" || in[2].Name != "Bob" {
+		t.Errorf("expected descending name order, got %+v", in)
+	}
+
+	sortEmployees(in, "", "")
+	if in[0].Id != 1 || in[2].Id != 3 {
+		t.Errorf("expected default ascending id order, got %+v", in)
+	}
+}
+
+func TestPaginationParams(t *testing.T) {
+	q, _ := url.ParseQuery("limit=2&offset=3")
+	limit, offset := paginationParams(q, 10)
+	if limit != 2 || offset != 3 {
+		t.Errorf("expected limit=2 offset=3, got limit=%d offset=%d", limit, offset)
+	}
+
+	q, _ = url.ParseQuery("offset=100")
+	_, offset = paginationParams(q, 10)
+	if offset != 10 {
+		t.Errorf("expected offset to clamp to total, got %d", offset)
+	}
+
+	q, _ = url.ParseQuery("")
+	limit, offset = paginationParams(q, 10)
+	if limit != defaultLimit || offset != 0 {
+		t.Errorf("expected defaults, got limit=%d offset=%d", limit, offset)
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	cursor := encodeCursor(42)
+	got, err := decodeCursor(cursor)
+	if err != nil {
+		t.Fatalf("decodeCursor: %v", err)
+	}
+	if got != 42 {
+		t.Errorf("expected 42, got %d", got)
+	}
+}
+
+func TestPaginationParamsCursorTakesPrecedenceOverOffset(t *testing.T) {
+	q, _ := url.ParseQuery("offset=5&cursor=" + encodeCursor(2))
+	_, offset := paginationParams(q, 10)
+	if offset != 2 {
+		t.Errorf("expected cursor to win over offset, got %d", offset)
+	}
+}
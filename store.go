@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNotFound is returned by Store implementations when no matching
+// employee record exists.
+var ErrNotFound = errors.New("employee not found")
+
+// Store abstracts the persistence backend for employee records so the
+// HTTP handlers don't have to know whether data lives in memory, in
+// BoltDB, or in PostgreSQL. Every method takes the request's context so
+// a DB-backed implementation can abort its query when the client
+// disconnects or the server is shutting down.
+type Store interface {
+	Create(ctx context.Context, e employee) (employee, error)
+	Get(ctx context.Context, id int) (employee, error)
+	GetByName(ctx context.Context, name string) (employee, error)
+	List(ctx context.Context) ([]employee, error)
+	Update(ctx context.Context, id int, e employee) (employee, error)
+	PartialUpdate(ctx context.Context, id int, e employee) (employee, error)
+	Delete(ctx context.Context, id int) error
+	DeleteByName(ctx context.Context, name string) error
+}
+
+// NewStore builds a Store for the given driver name. Supported drivers
+// are "memory" (the default), "bolt" and "postgres". dsn is driver
+// specific: a file path for bolt, a connection string for postgres, and
+// ignored for memory.
+func NewStore(driver, dsn string) (Store, error) {
+	switch driver {
+	case "", "memory":
+		return NewMemoryStore(), nil
+	case "bolt":
+		return NewBoltStore(dsn)
+	case "postgres":
+		return NewPostgresStore(dsn)
+	default:
+		return nil, fmt.Errorf("unknown store driver %q", driver)
+	}
+}
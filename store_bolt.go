@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var employeesBucket = []byte("employees")
+
+// boltStore persists employees in a single BoltDB bucket, keyed by the
+// big-endian encoding of the employee id. Each value is the JSON
+// encoding of the employee struct. bbolt transactions run to
+// completion once started, so ctx is only checked before a transaction
+// begins, not while it's in flight.
+type boltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore opens (creating if necessary) a BoltDB file at path and
+// ensures the employees bucket exists.
+func NewBoltStore(path string) (*boltStore, error) {
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(employeesBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &boltStore{db: db}, nil
+}
+
+func idKey(id int) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(id))
+	return key
+}
+
+func (s *boltStore) Create(ctx context.Context, e employee) (employee, error) {
+	if err := ctx.Err(); err != nil {
+		return employee{}, err
+	}
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(employeesBucket)
+		id, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		e.Id = int(id)
+		data, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		return b.Put(idKey(e.Id), data)
+	})
+	if err != nil {
+		return employee{}, err
+	}
+	return e, nil
+}
+
+func (s *boltStore) Get(ctx context.Context, id int) (employee, error) {
+	if err := ctx.Err(); err != nil {
+		return employee{}, err
+	}
+	var e employee
+	err := s.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(employeesBucket).Get(idKey(id))
+		if data == nil {
+			return ErrNotFound
+		}
+		return json.Unmarshal(data, &e)
+	})
+	return e, err
+}
+
+func (s *boltStore) GetByName(ctx context.Context, name string) (employee, error) {
+	if err := ctx.Err(); err != nil {
+		return employee{}, err
+	}
+	var e employee
+	found := false
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(employeesBucket).ForEach(func(k, v []byte) error {
+			var cur employee
+			if err := json.Unmarshal(v, &cur); err != nil {
+				return err
+			}
+			if cur.Name == name {
+				e = cur
+				found = true
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return employee{}, err
+	}
+	if !found {
+		return employee{}, ErrNotFound
+	}
+	return e, nil
+}
+
+func (s *boltStore) List(ctx context.Context) ([]employee, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var out []employee
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(employeesBucket).ForEach(func(k, v []byte) error {
+			var e employee
+			if err := json.Unmarshal(v, &e); err != nil {
+				return err
+			}
+			out = append(out, e)
+			return nil
+		})
+	})
+	return out, err
+}
+
+func (s *boltStore) Update(ctx context.Context, id int, e employee) (employee, error) {
+	if err := ctx.Err(); err != nil {
+		return employee{}, err
+	}
+	e.Id = id
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(employeesBucket)
+		if b.Get(idKey(id)) == nil {
+			return ErrNotFound
+		}
+		data, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		return b.Put(idKey(id), data)
+	})
+	if err != nil {
+		return employee{}, err
+	}
+	return e, nil
+}
+
+func (s *boltStore) PartialUpdate(ctx context.Context, id int, e employee) (employee, error) {
+	return s.Update(ctx, id, e)
+}
+
+func (s *boltStore) Delete(ctx context.Context, id int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(employeesBucket)
+		if b.Get(idKey(id)) == nil {
+			return ErrNotFound
+		}
+		return b.Delete(idKey(id))
+	})
+}
+
+func (s *boltStore) DeleteByName(ctx context.Context, name string) error {
+	e, err := s.GetByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	return s.Delete(ctx, e.Id)
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"sync"
+)
+
+// memoryStore is the default Store implementation: an in-memory slice
+// guarded by a mutex. Data does not survive a restart. It has no I/O to
+// cancel, so ctx is accepted only to satisfy the Store interface.
+type memoryStore struct {
+	mu    sync.Mutex
+	db    []employee
+	idSeq int
+}
+
+// NewMemoryStore returns an empty in-memory Store.
+func NewMemoryStore() *memoryStore {
+	return &memoryStore{}
+}
+
+func (s *memoryStore) Create(ctx context.Context, e employee) (employee, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.idSeq++
+	e.Id = s.idSeq
+	s.db = append(s.db, e)
+	return e, nil
+}
+
+func (s *memoryStore) Get(ctx context.Context, id int) (employee, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, v := range s.db {
+		if v.Id == id {
+			return v, nil
+		}
+	}
+	return employee{}, ErrNotFound
+}
+
+func (s *memoryStore) GetByName(ctx context.Context, name string) (employee, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, v := range s.db {
+		if v.Name == name {
+			return v, nil
+		}
+	}
+	return employee{}, ErrNotFound
+}
+
+func (s *memoryStore) List(ctx context.Context) ([]employee, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]employee, len(s.db))
+	copy(out, s.db)
+	return out, nil
+}
+
+func (s *memoryStore) Update(ctx context.Context, id int, e employee) (employee, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, v := range s.db {
+		if v.Id == id {
+			e.Id = id
+			s.db[k] = e
+			return e, nil
+		}
+	}
+	return employee{}, ErrNotFound
+}
+
+func (s *memoryStore) PartialUpdate(ctx context.Context, id int, e employee) (employee, error) {
+	return s.Update(ctx, id, e)
+}
+
+func (s *memoryStore) Delete(ctx context.Context, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, v := range s.db {
+		if v.Id == id {
+			s.db = append(s.db[:k], s.db[k+1:]...)
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+func (s *memoryStore) DeleteByName(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, v := range s.db {
+		if v.Name == name {
+			s.db = append(s.db[:k], s.db[k+1:]...)
+			return nil
+		}
+	}
+	return ErrNotFound
+}
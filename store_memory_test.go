@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMemoryStoreCreateAssignsSequentialIds(t *testing.T) {
+	s := NewMemoryStore()
+	ctx := context.Background()
+
+	bob, err := s.Create(ctx, employee{Name: "Bob", Age: 30})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	sara, err := s.Create(ctx, employee{Name: "Sara", Age: 34})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if bob.Id != 1 || sara.Id != 2 {
+		t.Errorf("expected sequential ids 1, 2, got %d, %d", bob.Id, sara.Id)
+	}
+}
+
+func TestMemoryStoreGetAndGetByName(t *testing.T) {
+	s := NewMemoryStore()
+	ctx := context.Background()
+	created, _ := s.Create(ctx, employee{Name: "Bob", Age: 30})
+
+	got, err := s.Get(ctx, created.Id)
+	if err != nil || got != created {
+		t.Errorf("Get: expected %+v, got %+v (err=%v)", created, got, err)
+	}
+
+	got, err = s.GetByName(ctx, "Bob")
+	if err != nil || got != created {
+		t.Errorf("GetByName: expected %+v, got %+v (err=%v)", created, got, err)
+	}
+
+	if _, err := s.Get(ctx, 999); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a missing id, got %v", err)
+	}
+	if _, err := s.GetByName(ctx, "Nobody"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a missing name, got %v", err)
+	}
+}
+
+func TestMemoryStoreUpdateAndPartialUpdate(t *testing.T) {
+	s := NewMemoryStore()
+	ctx := context.Background()
+	created, _ := s.Create(ctx, employee{Name: "Bob", Age: 30})
+
+	updated, err := s.Update(ctx, created.Id, employee{Name: "Bobby", Age: 31})
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if updated.Id != created.Id || updated.Name != "Bobby" || updated.Age != 31 {
+		t.Errorf("unexpected update result: %+v", updated)
+	}
+
+	if _, err := s.Update(ctx, 999, employee{Name: "X"}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound updating a missing id, got %v", err)
+	}
+
+	partial, err := s.PartialUpdate(ctx, created.Id, employee{Name: "Bobby", Age: 32})
+	if err != nil {
+		t.Fatalf("PartialUpdate: %v", err)
+	}
+	if partial.Age != 32 {
+		t.Errorf("expected age 32 after partial update, got %+v", partial)
+	}
+}
+
+func TestMemoryStoreDeleteAndDeleteByName(t *testing.T) {
+	s := NewMemoryStore()
+	ctx := context.Background()
+	bob, _ := s.Create(ctx, employee{Name: "Bob", Age: 30})
+	sara, _ := s.Create(ctx, employee{Name: "Sara", Age: 34})
+
+	if err := s.Delete(ctx, bob.Id); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := s.Get(ctx, bob.Id); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected Bob to be gone after Delete, got %v", err)
+	}
+
+	if err := s.DeleteByName(ctx, "Sara"); err != nil {
+		t.Fatalf("DeleteByName: %v", err)
+	}
+	if _, err := s.GetByName(ctx, sara.Name); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected Sara to be gone after DeleteByName, got %v", err)
+	}
+
+	if err := s.Delete(ctx, 999); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound deleting a missing id, got %v", err)
+	}
+	if err := s.DeleteByName(ctx, "Nobody"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound deleting a missing name, got %v", err)
+	}
+}
+
+func TestMemoryStoreListReturnsACopy(t *testing.T) {
+	s := NewMemoryStore()
+	ctx := context.Background()
+	s.Create(ctx, employee{Name: "Bob", Age: 30})
+
+	list, err := s.List(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	list[0].Name = "Mutated"
+
+	again, _ := s.List(ctx)
+	if again[0].Name == "Mutated" {
+		t.Error("expected List to return a copy, not the store's backing slice")
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// validate runs the struct tags declared on employee. A single package
+// level instance is safe for concurrent use and caches struct metadata.
+var validate = validator.New()
+
+// errorCode is a machine-readable identifier in the error envelope,
+// distinct from the human-readable message so callers can branch on it
+// without string matching.
+type errorCode string
+
+const (
+	errCodeDecode     errorCode = "E_DECODE"
+	errCodeValidation errorCode = "E_VALIDATION"
+	errCodeNotFound   errorCode = "E_NOT_FOUND"
+	errCodeConflict   errorCode = "E_CONFLICT"
+)
+
+// fieldError is one struct-tag violation, etcd httptypes-style.
+type fieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// apiError is the error envelope returned by writeError:
+// {"error": {"code": ..., "message": ..., "fields": [...]}}
+type apiError struct {
+	Code    errorCode    `json:"code"`
+	Message string       `json:"message"`
+	Fields  []fieldError `json:"fields,omitempty"`
+}
+
+// writeError sends the structured error envelope with the given status.
+func writeError(w http.ResponseWriter, status int, code errorCode, message string, fields []fieldError) {
+	webJSONResponse(w, status, map[string]interface{}{"error": apiError{Code: code, Message: message, Fields: fields}})
+}
+
+// validateEmployee runs struct-tag validation and, on failure, returns
+// per-field diagnostics suitable for a 422 response.
+func validateEmployee(e employee) []fieldError {
+	err := validate.Struct(e)
+	if err == nil {
+		return nil
+	}
+	validationErrs, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return []fieldError{{Message: err.Error()}}
+	}
+	fields := make([]fieldError, 0, len(validationErrs))
+	for _, fe := range validationErrs {
+		fields = append(fields, fieldError{
+			Field:   fe.Field(),
+			Rule:    fe.Tag(),
+			Message: fmt.Sprintf("%s failed on the %q rule", fe.Field(), fe.Tag()),
+		})
+	}
+	return fields
+}
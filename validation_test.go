@@ -0,0 +1,58 @@
+package main
+
+import "testing"
+
+func TestValidateEmployee(t *testing.T) {
+	tests := []struct {
+		name       string
+		e          employee
+		wantFields []string // expected fe.Field() values, in order
+	}{
+		{
+			name: "valid employee passes",
+			e:    employee{Name: "Bob", Age: 30},
+		},
+		{
+			name:       "empty name fails required",
+			e:          employee{Name: "", Age: 30},
+			wantFields: []string{"Name"},
+		},
+		{
+			name:       "name over max length fails",
+			e:          employee{Name: string(make([]byte, 101)), Age: 30},
+			wantFields: []string{"Name"},
+		},
+		{
+			name:       "negative age fails gte",
+			e:          employee{Name: "Bob", Age: -1},
+			wantFields: []string{"Age"},
+		},
+		{
+			name:       "age over max fails lte",
+			e:          employee{Name: "Bob", Age: 151},
+			wantFields: []string{"Age"},
+		},
+		{
+			name:       "empty name and bad age fail together",
+			e:          employee{Name: "", Age: -1},
+			wantFields: []string{"Name", "Age"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields := validateEmployee(tt.e)
+			if len(fields) != len(tt.wantFields) {
+				t.Fatalf("expected %d field errors, got %d: %+v", len(tt.wantFields), len(fields), fields)
+			}
+			for i, want := range tt.wantFields {
+				if fields[i].Field != want {
+					t.Errorf("field %d: expected %q, got %q", i, want, fields[i].Field)
+				}
+				if fields[i].Message == "" {
+					t.Errorf("field %d: expected a non-empty message", i)
+				}
+			}
+		})
+	}
+}
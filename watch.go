@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// changeAction describes what kind of mutation produced a changeEvent.
+type changeAction string
+
+const (
+	actionCreated changeAction = "created"
+	actionUpdated changeAction = "updated"
+	actionDeleted changeAction = "deleted"
+)
+
+// changeEvent is what a watcher returns to a caller of /employees/watch.
+type changeEvent struct {
+	Index    uint64       `json:"index"`
+	Action   changeAction `json:"action"`
+	Employee employee     `json:"employee"`
+}
+
+// watcher fans out changeEvents to goroutines blocked in wait, etcd-style.
+// It only remembers the most recent event, not a full history: a
+// watcher whose since index is already behind catches the latest
+// mutation but not every mutation it missed while disconnected.
+type watcher struct {
+	mu      sync.Mutex
+	index   uint64
+	last    *changeEvent
+	waiters map[chan changeEvent]struct{}
+}
+
+func newWatcher() *watcher {
+	return &watcher{waiters: make(map[chan changeEvent]struct{})}
+}
+
+// notify records a mutation and wakes any waiter blocked on wait.
+func (w *watcher) notify(action changeAction, e employee) {
+	w.mu.Lock()
+	w.index++
+	ev := changeEvent{Index: w.index, Action: action, Employee: e}
+	w.last = &ev
+	for ch := range w.waiters {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+	w.mu.Unlock()
+}
+
+// wait blocks until a change with an index greater than since occurs,
+// ctx is canceled, or timeout elapses. ok is false on timeout or
+// cancellation.
+func (w *watcher) wait(ctx context.Context, since uint64, timeout time.Duration) (changeEvent, bool) {
+	w.mu.Lock()
+	if w.last != nil && w.index > since {
+		ev := *w.last
+		w.mu.Unlock()
+		return ev, true
+	}
+	ch := make(chan changeEvent, 1)
+	w.waiters[ch] = struct{}{}
+	w.mu.Unlock()
+
+	defer func() {
+		w.mu.Lock()
+		delete(w.waiters, ch)
+		w.mu.Unlock()
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case ev := <-ch:
+			// A stale since (e.g. from a server restart that reset the
+			// index counter) must not be satisfied by an event that
+			// isn't actually past it; keep waiting for a qualifying one.
+			if ev.Index > since {
+				return ev, true
+			}
+		case <-ctx.Done():
+			return changeEvent{}, false
+		case <-timer.C:
+			return changeEvent{}, false
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWatcherNotifyWakesWaiter(t *testing.T) {
+	w := newWatcher()
+	done := make(chan changeEvent, 1)
+	go func() {
+		ev, ok := w.wait(context.Background(), 0, time.Second)
+		if !ok {
+			t.Error("expected wait to return an event, got timeout")
+		}
+		done <- ev
+	}()
+
+	// give the waiter goroutine a chance to register before notifying.
+	time.Sleep(10 * time.Millisecond)
+	w.notify(actionCreated, employee{Id: 1, Name: "Bob", Age: 30})
+
+	select {
+	case ev := <-done:
+		if ev.Index != 1 || ev.Action != actionCreated || ev.Employee.Name != "Bob" {
+			t.Errorf("unexpected event: %+v", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("waiter was never woken")
+	}
+}
+
+func TestWatcherWaitTimesOutWithNoChanges(t *testing.T) {
+	w := newWatcher()
+	_, ok := w.wait(context.Background(), 0, 20*time.Millisecond)
+	if ok {
+		t.Fatal("expected wait to time out, got an event")
+	}
+}
+
+func TestWatcherReplaysLatestEventWhenSinceIsBehind(t *testing.T) {
+	w := newWatcher()
+	w.notify(actionCreated, employee{Id: 1, Name: "Bob", Age: 30})
+	w.notify(actionUpdated, employee{Id: 1, Name: "Bob", Age: 31})
+
+	ev, ok := w.wait(context.Background(), 0, time.Second)
+	if !ok {
+		t.Fatal("expected an immediate event for a since index behind the latest")
+	}
+	if ev.Index != 2 || ev.Action != actionUpdated {
+		t.Errorf("expected the latest event, got %+v", ev)
+	}
+}
+
+func TestWatcherWaitIgnoresChannelEventNotPastSince(t *testing.T) {
+	w := newWatcher()
+	done := make(chan changeEvent, 1)
+	go func() {
+		// since=3 is ahead of the index at registration time, as it
+		// would be for a caller holding a since from before the server
+		// (and this in-memory index) restarted. The first three events
+		// delivered on the waiter channel don't clear it and must be
+		// skipped rather than returned.
+		ev, ok := w.wait(context.Background(), 3, time.Second)
+		if !ok {
+			t.Error("expected wait to return an event, got timeout")
+		}
+		done <- ev
+	}()
+
+	// Each notify is paced so the waiter goroutine has drained the
+	// previous one before the next is sent, since the waiter channel
+	// only buffers a single pending event.
+	time.Sleep(10 * time.Millisecond)
+	w.notify(actionCreated, employee{Id: 1, Name: "Bob", Age: 30}) // index 1, <= since
+	time.Sleep(10 * time.Millisecond)
+	w.notify(actionUpdated, employee{Id: 1, Name: "Bob", Age: 31}) // index 2, <= since
+	time.Sleep(10 * time.Millisecond)
+	w.notify(actionUpdated, employee{Id: 1, Name: "Bob", Age: 32}) // index 3, <= since
+	time.Sleep(10 * time.Millisecond)
+	w.notify(actionUpdated, employee{Id: 1, Name: "Bob", Age: 33}) // index 4, > since
+
+	select {
+	case ev := <-done:
+		if ev.Index != 4 {
+			t.Errorf("expected the first event past since=3 (index 4), got %+v", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("waiter was never woken")
+	}
+}
+
+func TestWatcherCancelledContextStopsWait(t *testing.T) {
+	w := newWatcher()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, ok := w.wait(ctx, 0, time.Second)
+	if ok {
+		t.Fatal("expected wait to abort on a cancelled context")
+	}
+}
+
+func TestWatcherBroadcastsToAllWaiters(t *testing.T) {
+	w := newWatcher()
+	const waiters = 5
+
+	var wg sync.WaitGroup
+	results := make([]bool, waiters)
+	for i := 0; i < waiters; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, ok := w.wait(context.Background(), 0, time.Second)
+			results[i] = ok
+		}(i)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	w.notify(actionDeleted, employee{Id: 1})
+	wg.Wait()
+
+	for i, ok := range results {
+		if !ok {
+			t.Errorf("waiter %d was never woken", i)
+		}
+	}
+}